@@ -0,0 +1,140 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+)
+
+const actionsBasePath = "v2/actions"
+
+// ActionsService handles communication with action related methods of the
+// DigitalOcean API.
+// See: https://developers.digitalocean.com/documentation/v2#actions
+type ActionsService interface {
+	List(context.Context, *ListOptions) ([]Action, *Response, error)
+	Get(context.Context, int) (*Action, *Response, error)
+	WaitForAction(context.Context, int, ...WaitOption) (*Action, error)
+}
+
+// ActionsServiceOp handles communication with the action related methods of
+// the DigitalOcean API.
+type ActionsServiceOp struct {
+	client *Client
+}
+
+var _ ActionsService = &ActionsServiceOp{}
+
+// Action represents a DigitalOcean Action
+type Action struct {
+	ID           int     `json:"id,float64,omitempty"`
+	Status       string  `json:"status,omitempty"`
+	Type         string  `json:"type,omitempty"`
+	StartedAt    string  `json:"started_at,omitempty"`
+	CompletedAt  string  `json:"completed_at,omitempty"`
+	ResourceID   int     `json:"resource_id,float64,omitempty"`
+	ResourceType string  `json:"resource_type,omitempty"`
+	Region       *Region `json:"region,omitempty"`
+}
+
+// String creates a human-readable description of an Action.
+func (a Action) String() string {
+	return Stringify(a)
+}
+
+type actionsRoot struct {
+	Actions []Action `json:"actions"`
+	Links   *Links   `json:"links"`
+}
+
+type actionRoot struct {
+	Event *Action `json:"action"`
+}
+
+// An ActionFailedError reports that an action reached the terminal
+// "errored" status. WaitForAction returns it so callers can use the
+// idiomatic if err != nil check to detect a failed action instead of
+// inspecting the returned Action's Status themselves.
+type ActionFailedError struct {
+	Action *Action
+}
+
+func (e *ActionFailedError) Error() string {
+	return fmt.Sprintf("action %d (%s) errored", e.Action.ID, e.Action.Type)
+}
+
+// List all actions.
+func (s *ActionsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Action, *Response, error) {
+	path, err := addOptions(actionsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionsRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Actions, resp, err
+}
+
+// Get an individual action by its identifier.
+func (s *ActionsServiceOp) Get(ctx context.Context, id int) (*Action, *Response, error) {
+	path := fmt.Sprintf("%s/%d", actionsBasePath, id)
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Event, resp, err
+}
+
+// WaitForAction blocks until the action reaches a terminal status
+// ("completed" or "errored"), returning the terminal action. If the action
+// errors, it returns the action alongside an *ActionFailedError so callers
+// can tell success and failure apart with the idiomatic if err != nil
+// check. Between attempts it backs off per pollBackoff (cap overridable
+// with WithMaxPollInterval) and honors a 429's Retry-After header. It
+// returns ctx's error as soon as ctx is cancelled or its deadline passes.
+func (s *ActionsServiceOp) WaitForAction(ctx context.Context, actionID int, opts ...WaitOption) (*Action, error) {
+	cfg := newWaitConfig(opts)
+
+	for attempt := 0; ; attempt++ {
+		action, _, err := s.Get(ctx, actionID)
+		if err != nil {
+			if wait, ok := retryAfter(err); ok {
+				if err := sleep(ctx, wait); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		switch action.Status {
+		case "completed":
+			return action, nil
+		case "errored":
+			return action, &ActionFailedError{Action: action}
+		}
+
+		if err := sleep(ctx, pollBackoff(attempt, cfg.maxInterval)); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestActions_WaitForAction_reachesTerminalStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/v2/actions/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+
+		status := "in-progress"
+		if calls >= 2 {
+			status = "completed"
+		}
+		fmt.Fprintf(w, `{"action":{"id":1,"status":%q}}`, status)
+	})
+
+	action, err := client.Actions.WaitForAction(ctx, 1, WithMaxPollInterval(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Actions.WaitForAction returned error: %v", err)
+	}
+
+	if action.Status != "completed" {
+		t.Errorf("Actions.WaitForAction returned status %q, want %q", action.Status, "completed")
+	}
+	if calls < 2 {
+		t.Errorf("Actions.WaitForAction returned after %d call(s), want it to have polled again", calls)
+	}
+}
+
+func TestActions_WaitForAction_reportsErroredAction(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/actions/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"action":{"id":1,"type":"resize","status":"errored"}}`)
+	})
+
+	action, err := client.Actions.WaitForAction(ctx, 1, WithMaxPollInterval(10*time.Millisecond))
+	if err == nil {
+		t.Fatal("Actions.WaitForAction returned nil error for an errored action, want *ActionFailedError")
+	}
+	if _, ok := err.(*ActionFailedError); !ok {
+		t.Errorf("Actions.WaitForAction returned error of type %T, want *ActionFailedError", err)
+	}
+	if action == nil || action.Status != "errored" {
+		t.Errorf("Actions.WaitForAction returned action %+v, want the errored action", action)
+	}
+}
+
+func TestActions_WaitForAction_honorsContextCancellation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/actions/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"action":{"id":1,"status":"in-progress"}}`)
+	})
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err := client.Actions.WaitForAction(cancelCtx, 1, WithMaxPollInterval(time.Minute))
+	if err != context.Canceled {
+		t.Errorf("Actions.WaitForAction returned %v, want context.Canceled", err)
+	}
+}
+
+func TestActions_WaitForAction_honorsRetryAfterOn429(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/v2/actions/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"message":"rate limited"}`)
+			return
+		}
+		fmt.Fprint(w, `{"action":{"id":1,"status":"completed"}}`)
+	})
+
+	action, err := client.Actions.WaitForAction(ctx, 1)
+	if err != nil {
+		t.Fatalf("Actions.WaitForAction returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Actions.WaitForAction made %d request(s), want 2 (one 429 retried once)", calls)
+	}
+	if action.Status != "completed" {
+		t.Errorf("Actions.WaitForAction returned status %q, want %q", action.Status, "completed")
+	}
+}
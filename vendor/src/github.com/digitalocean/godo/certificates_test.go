@@ -0,0 +1,95 @@
+package godo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCertificates_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/certificates/cert-id", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"certificate":{"id":"cert-id","name":"web-cert"}}`)
+	})
+
+	cert, _, err := client.Certificates.Get(ctx, "cert-id")
+	if err != nil {
+		t.Fatalf("Certificates.Get returned error: %v", err)
+	}
+
+	if cert.ID != "cert-id" || cert.Name != "web-cert" {
+		t.Errorf("Certificates.Get returned %+v, want id cert-id / name web-cert", cert)
+	}
+}
+
+func TestCertificates_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/certificates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"certificates":[{"id":"cert-1"},{"id":"cert-2"}]}`)
+	})
+
+	certs, _, err := client.Certificates.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("Certificates.List returned error: %v", err)
+	}
+
+	if len(certs) != 2 {
+		t.Errorf("Certificates.List returned %d certificates, want 2", len(certs))
+	}
+}
+
+func TestCertificates_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := &CertificateRequest{
+		Name:             "web-cert",
+		PrivateKey:       "key",
+		LeafCertificate:  "leaf",
+		CertificateChain: "chain",
+	}
+
+	mux.HandleFunc("/v2/certificates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		got := new(CertificateRequest)
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if *got != *want {
+			t.Errorf("Certificates.Create request body = %+v, want %+v", got, want)
+		}
+
+		fmt.Fprint(w, `{"certificate":{"id":"cert-id","name":"web-cert"}}`)
+	})
+
+	cert, _, err := client.Certificates.Create(ctx, want)
+	if err != nil {
+		t.Fatalf("Certificates.Create returned error: %v", err)
+	}
+
+	if cert.ID != "cert-id" || cert.Name != "web-cert" {
+		t.Errorf("Certificates.Create returned %+v, want id cert-id / name web-cert", cert)
+	}
+}
+
+func TestCertificates_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/certificates/cert-id", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Certificates.Delete(ctx, "cert-id")
+	if err != nil {
+		t.Fatalf("Certificates.Delete returned error: %v", err)
+	}
+}
@@ -1,8 +1,10 @@
 package godo
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 const dropletBasePath = "v2/droplets"
@@ -11,15 +13,19 @@ const dropletBasePath = "v2/droplets"
 // endpoints of the Digital Ocean API
 // See: https://developers.digitalocean.com/documentation/v2#droplets
 type DropletsService interface {
-	List(*ListOptions) ([]Droplet, *Response, error)
-	Get(int) (*Droplet, *Response, error)
-	Create(*DropletCreateRequest) (*Droplet, *Response, error)
-	Delete(int) (*Response, error)
-	Kernels(int, *ListOptions) ([]Kernel, *Response, error)
-	Snapshots(int, *ListOptions) ([]Image, *Response, error)
-	Backups(int, *ListOptions) ([]Image, *Response, error)
-	Actions(int, *ListOptions) ([]Action, *Response, error)
-	Neighbors(int) ([]Droplet, *Response, error)
+	List(context.Context, *ListOptions) ([]Droplet, *Response, error)
+	Get(context.Context, int) (*Droplet, *Response, error)
+	Create(context.Context, *DropletCreateRequest) (*Droplet, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	Kernels(context.Context, int, *ListOptions) ([]Kernel, *Response, error)
+	Snapshots(context.Context, int, *ListOptions) ([]Image, *Response, error)
+	Backups(context.Context, int, *ListOptions) ([]Image, *Response, error)
+	Actions(context.Context, int, *ListOptions) ([]Action, *Response, error)
+	Neighbors(context.Context, int) ([]Droplet, *Response, error)
+	ListByTag(context.Context, string, *ListOptions) ([]Droplet, *Response, error)
+	DeleteByTag(context.Context, string) (*Response, error)
+	CreateMultiple(context.Context, *DropletMultiCreateRequest) ([]Droplet, *Response, error)
+	WaitForActive(context.Context, int, ...WaitOption) (*Droplet, error)
 }
 
 // DropletsServiceOp handles communication with the droplet related methods of the
@@ -48,6 +54,7 @@ type Droplet struct {
 	Networks    *Networks `json:"networks,omitempty"`
 	ActionIDs   []int     `json:"action_ids,omitempty"`
 	Created     string    `json:"created_at,omitempty"`
+	Tags        []string  `json:"tags,omitempty"`
 }
 
 // Kernel object
@@ -131,12 +138,33 @@ type DropletCreateRequest struct {
 	IPv6              bool                  `json:"ipv6"`
 	PrivateNetworking bool                  `json:"private_networking"`
 	UserData          string                `json:"user_data,omitempty"`
+	Tags              []string              `json:"tags,omitempty"`
+	VolumeIDs         []string              `json:"volumes,omitempty"`
 }
 
 func (d DropletCreateRequest) String() string {
 	return Stringify(d)
 }
 
+// DropletMultiCreateRequest is a request to create multiple droplets from the
+// same configuration in a single call.
+type DropletMultiCreateRequest struct {
+	Names             []string              `json:"names"`
+	Region            string                `json:"region"`
+	Size              string                `json:"size"`
+	Image             DropletCreateImage    `json:"image"`
+	SSHKeys           []DropletCreateSSHKey `json:"ssh_keys"`
+	Backups           bool                  `json:"backups"`
+	IPv6              bool                  `json:"ipv6"`
+	PrivateNetworking bool                  `json:"private_networking"`
+	UserData          string                `json:"user_data,omitempty"`
+	Tags              []string              `json:"tags,omitempty"`
+}
+
+func (d DropletMultiCreateRequest) String() string {
+	return Stringify(d)
+}
+
 // Networks represents the droplet's networks
 type Networks struct {
 	V4 []NetworkV4 `json:"v4,omitempty"`
@@ -168,20 +196,45 @@ func (n NetworkV6) String() string {
 }
 
 // List all droplets
-func (s *DropletsServiceOp) List(opt *ListOptions) ([]Droplet, *Response, error) {
+func (s *DropletsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Droplet, *Response, error) {
 	path := dropletBasePath
 	path, err := addOptions(path, opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", path, nil)
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dropletsRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Droplets, resp, err
+}
+
+// ListByTag lists all droplets matching the specified tag.
+func (s *DropletsServiceOp) ListByTag(ctx context.Context, tag string, opt *ListOptions) ([]Droplet, *Response, error) {
+	path := fmt.Sprintf("%s?tag_name=%s", dropletBasePath, url.QueryEscape(tag))
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	root := new(dropletsRoot)
-	resp, err := s.client.Do(req, root)
+	resp, err := s.client.Do(ctx, req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -193,16 +246,16 @@ func (s *DropletsServiceOp) List(opt *ListOptions) ([]Droplet, *Response, error)
 }
 
 // Get individual droplet
-func (s *DropletsServiceOp) Get(dropletID int) (*Droplet, *Response, error) {
+func (s *DropletsServiceOp) Get(ctx context.Context, dropletID int) (*Droplet, *Response, error) {
 	path := fmt.Sprintf("%s/%d", dropletBasePath, dropletID)
 
-	req, err := s.client.NewRequest("GET", path, nil)
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	root := new(dropletRoot)
-	resp, err := s.client.Do(req, root)
+	resp, err := s.client.Do(ctx, req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -211,16 +264,16 @@ func (s *DropletsServiceOp) Get(dropletID int) (*Droplet, *Response, error) {
 }
 
 // Create droplet
-func (s *DropletsServiceOp) Create(createRequest *DropletCreateRequest) (*Droplet, *Response, error) {
+func (s *DropletsServiceOp) Create(ctx context.Context, createRequest *DropletCreateRequest) (*Droplet, *Response, error) {
 	path := dropletBasePath
 
-	req, err := s.client.NewRequest("POST", path, createRequest)
+	req, err := s.client.NewRequest(ctx, "POST", path, createRequest)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	root := new(dropletRoot)
-	resp, err := s.client.Do(req, root)
+	resp, err := s.client.Do(ctx, req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -231,35 +284,71 @@ func (s *DropletsServiceOp) Create(createRequest *DropletCreateRequest) (*Drople
 	return root.Droplet, resp, err
 }
 
+// CreateMultiple creates multiple droplets from the same configuration in a
+// single request.
+func (s *DropletsServiceOp) CreateMultiple(ctx context.Context, createRequest *DropletMultiCreateRequest) ([]Droplet, *Response, error) {
+	path := dropletBasePath
+
+	req, err := s.client.NewRequest(ctx, "POST", path, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dropletsRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Droplets, resp, err
+}
+
 // Delete droplet
-func (s *DropletsServiceOp) Delete(dropletID int) (*Response, error) {
+func (s *DropletsServiceOp) Delete(ctx context.Context, dropletID int) (*Response, error) {
 	path := fmt.Sprintf("%s/%d", dropletBasePath, dropletID)
 
-	req, err := s.client.NewRequest("DELETE", path, nil)
+	req, err := s.client.NewRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := s.client.Do(req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
+
+	return resp, err
+}
+
+// DeleteByTag deletes all droplets matching the specified tag.
+func (s *DropletsServiceOp) DeleteByTag(ctx context.Context, tag string) (*Response, error) {
+	path := fmt.Sprintf("%s?tag_name=%s", dropletBasePath, url.QueryEscape(tag))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
 
 	return resp, err
 }
 
 // Kernels lists kernels available for a droplet.
-func (s *DropletsServiceOp) Kernels(dropletID int, opt *ListOptions) ([]Kernel, *Response, error) {
+func (s *DropletsServiceOp) Kernels(ctx context.Context, dropletID int, opt *ListOptions) ([]Kernel, *Response, error) {
 	path := fmt.Sprintf("%s/%d/kernels", dropletBasePath, dropletID)
 	path, err := addOptions(path, opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", path, nil)
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	root := new(kernelsRoot)
-	resp, err := s.client.Do(req, root)
+	resp, err := s.client.Do(ctx, req, root)
 	if l := root.Links; l != nil {
 		resp.Links = l
 	}
@@ -268,20 +357,20 @@ func (s *DropletsServiceOp) Kernels(dropletID int, opt *ListOptions) ([]Kernel,
 }
 
 // Actions lists the actions for a droplet.
-func (s *DropletsServiceOp) Actions(dropletID int, opt *ListOptions) ([]Action, *Response, error) {
+func (s *DropletsServiceOp) Actions(ctx context.Context, dropletID int, opt *ListOptions) ([]Action, *Response, error) {
 	path := fmt.Sprintf("%s/%d/actions", dropletBasePath, dropletID)
 	path, err := addOptions(path, opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", path, nil)
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	root := new(actionsRoot)
-	resp, err := s.client.Do(req, root)
+	resp, err := s.client.Do(ctx, req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -293,20 +382,20 @@ func (s *DropletsServiceOp) Actions(dropletID int, opt *ListOptions) ([]Action,
 }
 
 // Backups lists the backups for a droplet.
-func (s *DropletsServiceOp) Backups(dropletID int, opt *ListOptions) ([]Image, *Response, error) {
+func (s *DropletsServiceOp) Backups(ctx context.Context, dropletID int, opt *ListOptions) ([]Image, *Response, error) {
 	path := fmt.Sprintf("%s/%d/backups", dropletBasePath, dropletID)
 	path, err := addOptions(path, opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", path, nil)
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	root := new(backupsRoot)
-	resp, err := s.client.Do(req, root)
+	resp, err := s.client.Do(ctx, req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -318,20 +407,20 @@ func (s *DropletsServiceOp) Backups(dropletID int, opt *ListOptions) ([]Image, *
 }
 
 // Snapshots lists the snapshots available for a droplet.
-func (s *DropletsServiceOp) Snapshots(dropletID int, opt *ListOptions) ([]Image, *Response, error) {
+func (s *DropletsServiceOp) Snapshots(ctx context.Context, dropletID int, opt *ListOptions) ([]Image, *Response, error) {
 	path := fmt.Sprintf("%s/%d/snapshots", dropletBasePath, dropletID)
 	path, err := addOptions(path, opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", path, nil)
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	root := new(snapshotsRoot)
-	resp, err := s.client.Do(req, root)
+	resp, err := s.client.Do(ctx, req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -343,16 +432,16 @@ func (s *DropletsServiceOp) Snapshots(dropletID int, opt *ListOptions) ([]Image,
 }
 
 // Neighbors lists the neighbors for a droplet.
-func (s *DropletsServiceOp) Neighbors(dropletID int) ([]Droplet, *Response, error) {
+func (s *DropletsServiceOp) Neighbors(ctx context.Context, dropletID int) ([]Droplet, *Response, error) {
 	path := fmt.Sprintf("%s/%d/neighbors", dropletBasePath, dropletID)
 
-	req, err := s.client.NewRequest("GET", path, nil)
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	root := new(dropletsRoot)
-	resp, err := s.client.Do(req, root)
+	resp, err := s.client.Do(ctx, req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -360,12 +449,32 @@ func (s *DropletsServiceOp) Neighbors(dropletID int) ([]Droplet, *Response, erro
 	return root.Droplets, resp, err
 }
 
-func (s *DropletsServiceOp) dropletActionStatus(uri string) (string, error) {
-	action, _, err := s.client.DropletActions.GetByURI(uri)
-
-	if err != nil {
-		return "", err
+// WaitForActive blocks until the droplet's status reaches "active",
+// returning the droplet as last observed. Between attempts it backs off per
+// pollBackoff (cap overridable with WithMaxPollInterval) and honors a 429's
+// Retry-After header. Cancelling ctx (or its deadline elapsing) aborts the
+// loop and returns ctx's error.
+func (s *DropletsServiceOp) WaitForActive(ctx context.Context, dropletID int, opts ...WaitOption) (*Droplet, error) {
+	cfg := newWaitConfig(opts)
+
+	for attempt := 0; ; attempt++ {
+		droplet, _, err := s.Get(ctx, dropletID)
+		if err != nil {
+			if wait, ok := retryAfter(err); ok {
+				if err := sleep(ctx, wait); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		if droplet.Status == "active" {
+			return droplet, nil
+		}
+
+		if err := sleep(ctx, pollBackoff(attempt, cfg.maxInterval)); err != nil {
+			return nil, err
+		}
 	}
-
-	return action.Status, nil
 }
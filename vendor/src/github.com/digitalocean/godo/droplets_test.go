@@ -0,0 +1,179 @@
+package godo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDroplets_ListByTag_withPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		if tag := r.URL.Query().Get("tag_name"); tag != "prod" {
+			t.Errorf("Request tag_name = %q, want %q", tag, "prod")
+		}
+		if page := r.URL.Query().Get("page"); page != "2" {
+			t.Errorf("Request page = %q, want %q", page, "2")
+		}
+
+		fmt.Fprint(w, `{"droplets":[{"id":1}]}`)
+	})
+
+	droplets, _, err := client.Droplets.ListByTag(ctx, "prod", &ListOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("Droplets.ListByTag returned error: %v", err)
+	}
+
+	if len(droplets) != 1 || droplets[0].ID != 1 {
+		t.Errorf("Droplets.ListByTag returned %+v, want one droplet with ID 1", droplets)
+	}
+}
+
+func TestDroplets_ListByTag_escapesTagValue(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		if tag := r.URL.Query().Get("tag_name"); tag != "prod&page=999" {
+			t.Errorf("Request tag_name = %q, want %q", tag, "prod&page=999")
+		}
+		if len(r.URL.Query()["page"]) != 0 {
+			t.Errorf("Request page = %q, want no page parameter injected", r.URL.Query()["page"])
+		}
+
+		fmt.Fprint(w, `{"droplets":[{"id":1}]}`)
+	})
+
+	_, _, err := client.Droplets.ListByTag(ctx, "prod&page=999", nil)
+	if err != nil {
+		t.Fatalf("Droplets.ListByTag returned error: %v", err)
+	}
+}
+
+func TestDroplets_DeleteByTag_escapesTagValue(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		if tag := r.URL.Query().Get("tag_name"); tag != "prod cluster" {
+			t.Errorf("Request tag_name = %q, want %q", tag, "prod cluster")
+		}
+	})
+
+	_, err := client.Droplets.DeleteByTag(ctx, "prod cluster")
+	if err != nil {
+		t.Fatalf("Droplets.DeleteByTag returned error: %v", err)
+	}
+}
+
+func TestDroplets_CreateMultiple_usesPluralNames(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &DropletMultiCreateRequest{
+		Names:  []string{"sub-01", "sub-02"},
+		Region: "nyc1",
+		Size:   "s-1vcpu-1gb",
+		Image:  DropletCreateImage{Slug: "ubuntu-18-04-x64"},
+	}
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var body struct {
+			Names []string `json:"names"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if len(body.Names) != 2 || body.Names[0] != "sub-01" || body.Names[1] != "sub-02" {
+			t.Errorf("Droplets.CreateMultiple request body names = %+v, want [sub-01 sub-02]", body.Names)
+		}
+
+		fmt.Fprint(w, `{"droplets":[{"id":1,"name":"sub-01"},{"id":2,"name":"sub-02"}]}`)
+	})
+
+	droplets, _, err := client.Droplets.CreateMultiple(ctx, createRequest)
+	if err != nil {
+		t.Fatalf("Droplets.CreateMultiple returned error: %v", err)
+	}
+
+	if len(droplets) != 2 || droplets[0].ID != 1 || droplets[1].ID != 2 {
+		t.Errorf("Droplets.CreateMultiple returned %+v, want two droplets with IDs 1 and 2", droplets)
+	}
+}
+
+func TestDroplets_DeleteByTag(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		if tag := r.URL.Query().Get("tag_name"); tag != "prod" {
+			t.Errorf("Request tag_name = %q, want %q", tag, "prod")
+		}
+	})
+
+	_, err := client.Droplets.DeleteByTag(ctx, "prod")
+	if err != nil {
+		t.Fatalf("Droplets.DeleteByTag returned error: %v", err)
+	}
+}
+
+func TestDroplets_WaitForActive_reachesTerminalStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+
+		status := "new"
+		if calls >= 2 {
+			status = "active"
+		}
+		fmt.Fprintf(w, `{"droplet":{"id":1,"status":%q}}`, status)
+	})
+
+	droplet, err := client.Droplets.WaitForActive(ctx, 1, WithMaxPollInterval(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Droplets.WaitForActive returned error: %v", err)
+	}
+
+	if droplet.Status != "active" {
+		t.Errorf("Droplets.WaitForActive returned status %q, want %q", droplet.Status, "active")
+	}
+	if calls < 2 {
+		t.Errorf("Droplets.WaitForActive returned after %d call(s), want it to have polled again", calls)
+	}
+}
+
+func TestDroplets_WaitForActive_honorsContextCancellation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"droplet":{"id":1,"status":"new"}}`)
+	})
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err := client.Droplets.WaitForActive(cancelCtx, 1, WithMaxPollInterval(time.Minute))
+	if err != context.Canceled {
+		t.Errorf("Droplets.WaitForActive returned %v, want context.Canceled", err)
+	}
+}
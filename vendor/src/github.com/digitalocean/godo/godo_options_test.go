@@ -0,0 +1,27 @@
+package godo
+
+import "testing"
+
+func TestAddOptions_mergesExistingQuery(t *testing.T) {
+	got, err := addOptions("v2/droplets?tag_name=prod", &ListOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("addOptions returned error: %v", err)
+	}
+
+	want := "v2/droplets?page=2&tag_name=prod"
+	if got != want {
+		t.Errorf("addOptions = %q, want %q", got, want)
+	}
+}
+
+func TestAddOptions_nilOptsLeavesQueryUntouched(t *testing.T) {
+	got, err := addOptions("v2/droplets?tag_name=prod", (*ListOptions)(nil))
+	if err != nil {
+		t.Fatalf("addOptions returned error: %v", err)
+	}
+
+	want := "v2/droplets?tag_name=prod"
+	if got != want {
+		t.Errorf("addOptions = %q, want %q", got, want)
+	}
+}
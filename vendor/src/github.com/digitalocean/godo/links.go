@@ -0,0 +1,62 @@
+package godo
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// Links manages links that are returned along with a given request.
+type Links struct {
+	Pages *Pages `json:"pages,omitempty"`
+}
+
+// Pages are pagination details preset in the response.
+type Pages struct {
+	First string `json:"first,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Last  string `json:"last,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// CurrentPage is current page of the list, derived from the next or previous
+// page link. It returns 0 when the response carries neither link.
+func (l *Links) CurrentPage() (int, error) {
+	return l.Pages.current()
+}
+
+func (p *Pages) current() (int, error) {
+	if p == nil {
+		return 0, nil
+	}
+
+	if p.Next == "" {
+		if p.Prev == "" {
+			return 0, nil
+		}
+
+		u, err := url.Parse(p.Prev)
+		if err != nil {
+			return 0, err
+		}
+
+		page, err := strconv.Atoi(u.Query().Get("page"))
+		if err != nil {
+			return 0, err
+		}
+
+		return page + 1, nil
+	}
+
+	u, err := url.Parse(p.Next)
+	if err != nil {
+		return 0, err
+	}
+
+	pageStr := u.Query().Get("page")
+	page, err := strconv.Atoi(pageStr)
+	if err != nil {
+		return 0, err
+	}
+
+	return page - 1, nil
+}
@@ -0,0 +1,223 @@
+package godo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestLoadBalancers_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/load_balancers/lb-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"load_balancer":{"id":"lb-1","name":"web-lb"}}`)
+	})
+
+	lb, _, err := client.LoadBalancers.Get(ctx, "lb-1")
+	if err != nil {
+		t.Fatalf("LoadBalancers.Get returned error: %v", err)
+	}
+
+	if lb.ID != "lb-1" || lb.Name != "web-lb" {
+		t.Errorf("LoadBalancers.Get returned %+v, want id lb-1 / name web-lb", lb)
+	}
+}
+
+func TestLoadBalancers_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/load_balancers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"load_balancers":[{"id":"lb-1"},{"id":"lb-2"}]}`)
+	})
+
+	lbs, _, err := client.LoadBalancers.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("LoadBalancers.List returned error: %v", err)
+	}
+
+	if len(lbs) != 2 {
+		t.Errorf("LoadBalancers.List returned %d load balancers, want 2", len(lbs))
+	}
+}
+
+func TestLoadBalancers_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := &LoadBalancerRequest{
+		Name:      "web-lb",
+		Algorithm: "round_robin",
+		Region:    "nyc1",
+	}
+
+	mux.HandleFunc("/v2/load_balancers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		got := new(LoadBalancerRequest)
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("LoadBalancers.Create request body = %+v, want %+v", got, want)
+		}
+
+		fmt.Fprint(w, `{"load_balancer":{"id":"lb-1","name":"web-lb"}}`)
+	})
+
+	lb, _, err := client.LoadBalancers.Create(ctx, want)
+	if err != nil {
+		t.Fatalf("LoadBalancers.Create returned error: %v", err)
+	}
+
+	if lb.ID != "lb-1" {
+		t.Errorf("LoadBalancers.Create returned %+v, want id lb-1", lb)
+	}
+}
+
+func TestLoadBalancers_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	update := &LoadBalancerRequest{Name: "web-lb-renamed"}
+
+	mux.HandleFunc("/v2/load_balancers/lb-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		got := new(LoadBalancerRequest)
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if !reflect.DeepEqual(got, update) {
+			t.Errorf("LoadBalancers.Update request body = %+v, want %+v", got, update)
+		}
+
+		fmt.Fprint(w, `{"load_balancer":{"id":"lb-1","name":"web-lb-renamed"}}`)
+	})
+
+	lb, _, err := client.LoadBalancers.Update(ctx, "lb-1", update)
+	if err != nil {
+		t.Fatalf("LoadBalancers.Update returned error: %v", err)
+	}
+
+	if lb.Name != "web-lb-renamed" {
+		t.Errorf("LoadBalancers.Update returned %+v, want name web-lb-renamed", lb)
+	}
+}
+
+func TestLoadBalancers_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/load_balancers/lb-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.LoadBalancers.Delete(ctx, "lb-1")
+	if err != nil {
+		t.Fatalf("LoadBalancers.Delete returned error: %v", err)
+	}
+}
+
+func TestLoadBalancers_AddDroplets(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := &dropletIDsRequest{DropletIDs: []int{1, 2}}
+
+	mux.HandleFunc("/v2/load_balancers/lb-1/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		got := new(dropletIDsRequest)
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("LoadBalancers.AddDroplets request body = %+v, want %+v", got, want)
+		}
+	})
+
+	_, err := client.LoadBalancers.AddDroplets(ctx, "lb-1", 1, 2)
+	if err != nil {
+		t.Fatalf("LoadBalancers.AddDroplets returned error: %v", err)
+	}
+}
+
+func TestLoadBalancers_RemoveDroplets(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := &dropletIDsRequest{DropletIDs: []int{1, 2}}
+
+	mux.HandleFunc("/v2/load_balancers/lb-1/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		got := new(dropletIDsRequest)
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("LoadBalancers.RemoveDroplets request body = %+v, want %+v", got, want)
+		}
+	})
+
+	_, err := client.LoadBalancers.RemoveDroplets(ctx, "lb-1", 1, 2)
+	if err != nil {
+		t.Fatalf("LoadBalancers.RemoveDroplets returned error: %v", err)
+	}
+}
+
+func TestLoadBalancers_AddForwardingRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	rule := ForwardingRule{EntryProtocol: "https", EntryPort: 443, TargetProtocol: "http", TargetPort: 80}
+	want := &forwardingRulesRequest{ForwardingRules: []ForwardingRule{rule}}
+
+	mux.HandleFunc("/v2/load_balancers/lb-1/forwarding_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		got := new(forwardingRulesRequest)
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("LoadBalancers.AddForwardingRules request body = %+v, want %+v", got, want)
+		}
+	})
+
+	_, err := client.LoadBalancers.AddForwardingRules(ctx, "lb-1", rule)
+	if err != nil {
+		t.Fatalf("LoadBalancers.AddForwardingRules returned error: %v", err)
+	}
+}
+
+func TestLoadBalancers_RemoveForwardingRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	rule := ForwardingRule{EntryProtocol: "https", EntryPort: 443, TargetProtocol: "http", TargetPort: 80}
+	want := &forwardingRulesRequest{ForwardingRules: []ForwardingRule{rule}}
+
+	mux.HandleFunc("/v2/load_balancers/lb-1/forwarding_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		got := new(forwardingRulesRequest)
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("LoadBalancers.RemoveForwardingRules request body = %+v, want %+v", got, want)
+		}
+	})
+
+	_, err := client.LoadBalancers.RemoveForwardingRules(ctx, "lb-1", rule)
+	if err != nil {
+		t.Fatalf("LoadBalancers.RemoveForwardingRules returned error: %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package godo
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultPollInterval    = 2 * time.Second
+	defaultMaxPollInterval = 30 * time.Second
+)
+
+// waitConfig holds the tunable parameters of a WaitForAction/WaitForActive
+// poll loop. It's built from a slice of WaitOption, defaulting to
+// defaultMaxPollInterval.
+type waitConfig struct {
+	maxInterval time.Duration
+}
+
+// WaitOption customizes the polling behavior of WaitForAction/WaitForActive.
+type WaitOption func(*waitConfig)
+
+// WithMaxPollInterval caps how long a WaitForAction/WaitForActive poll loop
+// will back off to between attempts, overriding defaultMaxPollInterval.
+func WithMaxPollInterval(d time.Duration) WaitOption {
+	return func(c *waitConfig) {
+		c.maxInterval = d
+	}
+}
+
+func newWaitConfig(opts []WaitOption) *waitConfig {
+	c := &waitConfig{maxInterval: defaultMaxPollInterval}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// pollBackoff returns the interval to wait before the next poll attempt,
+// doubling on each attempt up to maxInterval and adding jitter so that many
+// concurrent callers don't all poll in lockstep.
+func pollBackoff(attempt int, maxInterval time.Duration) time.Duration {
+	interval := defaultPollInterval
+	for i := 0; i < attempt && interval < maxInterval; i++ {
+		interval *= 2
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(interval) / 2))
+	return interval/2 + jitter
+}
+
+// sleep waits for d, returning ctx.Err() early if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// retryAfter reports the duration a caller should wait before retrying a
+// request that failed with a 429, based on the response's Retry-After
+// header. It returns false if err isn't a rate-limit response.
+func retryAfter(err error) (time.Duration, bool) {
+	errResp, ok := err.(*ErrorResponse)
+	if !ok || errResp.Response == nil || errResp.Response.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	ra := errResp.Response.Header.Get("Retry-After")
+	if ra == "" {
+		return 0, false
+	}
+
+	secs, err := strconv.Atoi(ra)
+	if err != nil {
+		return 0, false
+	}
+
+	return time.Duration(secs) * time.Second, true
+}
@@ -0,0 +1,67 @@
+package godo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter_parsesRateLimitResponse(t *testing.T) {
+	err := &ErrorResponse{
+		Response: &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"Retry-After": []string{"3"}},
+		},
+	}
+
+	wait, ok := retryAfter(err)
+	if !ok {
+		t.Fatal("retryAfter reported no wait for a 429 with Retry-After set")
+	}
+	if wait != 3*time.Second {
+		t.Errorf("retryAfter = %v, want 3s", wait)
+	}
+}
+
+func TestRetryAfter_ignoresNonRateLimitErrors(t *testing.T) {
+	err := &ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusInternalServerError},
+	}
+
+	if _, ok := retryAfter(err); ok {
+		t.Error("retryAfter reported a wait for a non-429 error")
+	}
+}
+
+func TestSleep_returnsCtxErrOnCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := sleep(ctx, time.Minute); err != context.Canceled {
+		t.Errorf("sleep returned %v, want context.Canceled", err)
+	}
+}
+
+func TestNewWaitConfig_appliesMaxPollIntervalOption(t *testing.T) {
+	cfg := newWaitConfig([]WaitOption{WithMaxPollInterval(5 * time.Second)})
+	if cfg.maxInterval != 5*time.Second {
+		t.Errorf("maxInterval = %v, want 5s", cfg.maxInterval)
+	}
+}
+
+func TestNewWaitConfig_defaultsMaxPollInterval(t *testing.T) {
+	cfg := newWaitConfig(nil)
+	if cfg.maxInterval != defaultMaxPollInterval {
+		t.Errorf("maxInterval = %v, want %v", cfg.maxInterval, defaultMaxPollInterval)
+	}
+}
+
+func TestPollBackoff_neverExceedsMaxInterval(t *testing.T) {
+	max := 4 * time.Second
+	for attempt := 0; attempt < 10; attempt++ {
+		if got := pollBackoff(attempt, max); got > max {
+			t.Errorf("pollBackoff(%d, %v) = %v, want <= %v", attempt, max, got, max)
+		}
+	}
+}
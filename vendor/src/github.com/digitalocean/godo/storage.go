@@ -0,0 +1,164 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+)
+
+const storageBasePath = "v2/volumes"
+
+// StorageService is an interface for interfacing with the volumes
+// endpoints of the DigitalOcean API.
+// See: https://developers.digitalocean.com/documentation/v2#block-storage
+type StorageService interface {
+	ListVolumes(context.Context, *ListVolumeParams) ([]Volume, *Response, error)
+	GetVolume(context.Context, string) (*Volume, *Response, error)
+	CreateVolume(context.Context, *VolumeCreateRequest) (*Volume, *Response, error)
+	DeleteVolume(context.Context, string) (*Response, error)
+	ListSnapshots(context.Context, string, *ListOptions) ([]Image, *Response, error)
+}
+
+// StorageServiceOp handles communication with the storage volumes related
+// methods of the DigitalOcean API.
+type StorageServiceOp struct {
+	client *Client
+}
+
+var _ StorageService = &StorageServiceOp{}
+
+// Volume represents a DigitalOcean block storage volume.
+type Volume struct {
+	ID            string  `json:"id"`
+	Region        *Region `json:"region,omitempty"`
+	Name          string  `json:"name"`
+	SizeGigaBytes int64   `json:"size_gigabytes"`
+	Description   string  `json:"description"`
+	DropletIDs    []int   `json:"droplet_ids"`
+	CreatedAt     string  `json:"created_at,omitempty"`
+}
+
+// String creates a human-readable description of a Volume.
+func (v Volume) String() string {
+	return Stringify(v)
+}
+
+// ListVolumeParams is used to filter List requests by region or name.
+type ListVolumeParams struct {
+	*ListOptions
+	Region string `url:"region,omitempty"`
+	Name   string `url:"name,omitempty"`
+}
+
+// VolumeCreateRequest represents a request to create a block storage volume.
+type VolumeCreateRequest struct {
+	Region        string `json:"region"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	SizeGigaBytes int64  `json:"size_gigabytes"`
+	SnapshotID    string `json:"snapshot_id,omitempty"`
+}
+
+type storageVolumesRoot struct {
+	Volumes []Volume `json:"volumes"`
+	Links   *Links   `json:"links"`
+}
+
+type storageVolumeRoot struct {
+	Volume *Volume `json:"volume"`
+}
+
+// ListVolumes lists all block storage volumes, optionally filtered by region
+// or name.
+func (s *StorageServiceOp) ListVolumes(ctx context.Context, params *ListVolumeParams) ([]Volume, *Response, error) {
+	path, err := addOptions(storageBasePath, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(storageVolumesRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Volumes, resp, err
+}
+
+// GetVolume retrieves an individual block storage volume by its identifier.
+func (s *StorageServiceOp) GetVolume(ctx context.Context, id string) (*Volume, *Response, error) {
+	path := fmt.Sprintf("%s/%s", storageBasePath, id)
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(storageVolumeRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Volume, resp, err
+}
+
+// CreateVolume creates a new block storage volume.
+func (s *StorageServiceOp) CreateVolume(ctx context.Context, createRequest *VolumeCreateRequest) (*Volume, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", storageBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(storageVolumeRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Volume, resp, err
+}
+
+// DeleteVolume deletes a block storage volume.
+func (s *StorageServiceOp) DeleteVolume(ctx context.Context, id string) (*Response, error) {
+	path := fmt.Sprintf("%s/%s", storageBasePath, id)
+
+	req, err := s.client.NewRequest(ctx, "DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListSnapshots lists the snapshots available for a block storage volume.
+func (s *StorageServiceOp) ListSnapshots(ctx context.Context, volumeID string, opt *ListOptions) ([]Image, *Response, error) {
+	path := fmt.Sprintf("%s/%s/snapshots", storageBasePath, volumeID)
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(snapshotsRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Snapshots, resp, err
+}
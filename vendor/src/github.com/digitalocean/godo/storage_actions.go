@@ -0,0 +1,73 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+)
+
+// StorageActionsService is an interface for interfacing with the
+// storage actions endpoints of the DigitalOcean API.
+// See: https://developers.digitalocean.com/documentation/v2#storage-actions
+type StorageActionsService interface {
+	Attach(ctx context.Context, volumeID string, dropletID int) (*Action, *Response, error)
+	DetachByDropletID(ctx context.Context, volumeID string, dropletID int) (*Action, *Response, error)
+	Resize(ctx context.Context, volumeID string, sizeGigabytes int, region string) (*Action, *Response, error)
+}
+
+// StorageActionsServiceOp handles communication with the storage volumes
+// action related methods of the DigitalOcean API.
+type StorageActionsServiceOp struct {
+	client *Client
+}
+
+var _ StorageActionsService = &StorageActionsServiceOp{}
+
+// StorageAttachment represents a request to attach/detach a storage volume
+// to/from a droplet.
+type StorageAttachment struct {
+	Type      string `json:"type"`
+	DropletID int    `json:"droplet_id"`
+}
+
+// StorageResizeRequest represents a request to resize a storage volume.
+type StorageResizeRequest struct {
+	Type          string `json:"type"`
+	SizeGigabytes int    `json:"size_gigabytes"`
+	Region        string `json:"region"`
+}
+
+// Attach a storage volume to a droplet.
+func (s *StorageActionsServiceOp) Attach(ctx context.Context, volumeID string, dropletID int) (*Action, *Response, error) {
+	request := &StorageAttachment{Type: "attach", DropletID: dropletID}
+	return s.doAction(ctx, volumeID, request)
+}
+
+// DetachByDropletID detaches a storage volume from a droplet by its
+// identifier.
+func (s *StorageActionsServiceOp) DetachByDropletID(ctx context.Context, volumeID string, dropletID int) (*Action, *Response, error) {
+	request := &StorageAttachment{Type: "detach", DropletID: dropletID}
+	return s.doAction(ctx, volumeID, request)
+}
+
+// Resize a storage volume.
+func (s *StorageActionsServiceOp) Resize(ctx context.Context, volumeID string, sizeGigabytes int, region string) (*Action, *Response, error) {
+	request := &StorageResizeRequest{Type: "resize", SizeGigabytes: sizeGigabytes, Region: region}
+	return s.doAction(ctx, volumeID, request)
+}
+
+func (s *StorageActionsServiceOp) doAction(ctx context.Context, volumeID string, request interface{}) (*Action, *Response, error) {
+	path := fmt.Sprintf("%s/%s/actions", storageBasePath, volumeID)
+
+	req, err := s.client.NewRequest(ctx, "POST", path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Event, resp, err
+}
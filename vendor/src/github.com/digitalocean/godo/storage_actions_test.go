@@ -0,0 +1,98 @@
+package godo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestStorageActions_Attach(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := &StorageAttachment{Type: "attach", DropletID: 12345}
+
+	mux.HandleFunc("/v2/volumes/vol-1/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		got := new(StorageAttachment)
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if *got != *want {
+			t.Errorf("StorageActions.Attach request body = %+v, want %+v", got, want)
+		}
+
+		fmt.Fprint(w, `{"action":{"id":1,"status":"in-progress"}}`)
+	})
+
+	action, _, err := client.StorageActions.Attach(ctx, "vol-1", 12345)
+	if err != nil {
+		t.Fatalf("StorageActions.Attach returned error: %v", err)
+	}
+
+	if action.ID != 1 {
+		t.Errorf("StorageActions.Attach returned %+v, want id 1", action)
+	}
+}
+
+func TestStorageActions_DetachByDropletID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := &StorageAttachment{Type: "detach", DropletID: 12345}
+
+	mux.HandleFunc("/v2/volumes/vol-1/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		got := new(StorageAttachment)
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if *got != *want {
+			t.Errorf("StorageActions.DetachByDropletID request body = %+v, want %+v", got, want)
+		}
+
+		fmt.Fprint(w, `{"action":{"id":2,"status":"in-progress"}}`)
+	})
+
+	action, _, err := client.StorageActions.DetachByDropletID(ctx, "vol-1", 12345)
+	if err != nil {
+		t.Fatalf("StorageActions.DetachByDropletID returned error: %v", err)
+	}
+
+	if action.ID != 2 {
+		t.Errorf("StorageActions.DetachByDropletID returned %+v, want id 2", action)
+	}
+}
+
+func TestStorageActions_Resize(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := &StorageResizeRequest{Type: "resize", SizeGigabytes: 100, Region: "nyc1"}
+
+	mux.HandleFunc("/v2/volumes/vol-1/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		got := new(StorageResizeRequest)
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if *got != *want {
+			t.Errorf("StorageActions.Resize request body = %+v, want %+v", got, want)
+		}
+
+		fmt.Fprint(w, `{"action":{"id":3,"status":"in-progress"}}`)
+	})
+
+	action, _, err := client.StorageActions.Resize(ctx, "vol-1", 100, "nyc1")
+	if err != nil {
+		t.Fatalf("StorageActions.Resize returned error: %v", err)
+	}
+
+	if action.ID != 3 {
+		t.Errorf("StorageActions.Resize returned %+v, want id 3", action)
+	}
+}
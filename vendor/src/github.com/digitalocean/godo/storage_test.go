@@ -0,0 +1,158 @@
+package godo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestStorage_ListVolumes_withFiltersAndPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		q := r.URL.Query()
+		if name := q.Get("name"); name != "my-volume" {
+			t.Errorf("Request name = %q, want %q", name, "my-volume")
+		}
+		if region := q.Get("region"); region != "nyc1" {
+			t.Errorf("Request region = %q, want %q", region, "nyc1")
+		}
+		if page := q.Get("page"); page != "2" {
+			t.Errorf("Request page = %q, want %q", page, "2")
+		}
+
+		fmt.Fprint(w, `{"volumes":[{"id":"v1"}]}`)
+	})
+
+	params := &ListVolumeParams{
+		Name:        "my-volume",
+		Region:      "nyc1",
+		ListOptions: &ListOptions{Page: 2},
+	}
+
+	volumes, _, err := client.Storage.ListVolumes(ctx, params)
+	if err != nil {
+		t.Fatalf("Storage.ListVolumes returned error: %v", err)
+	}
+
+	if len(volumes) != 1 || volumes[0].ID != "v1" {
+		t.Errorf("Storage.ListVolumes returned %+v, want one volume with ID v1", volumes)
+	}
+}
+
+func TestStorage_ListVolumes_withoutListOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		q := r.URL.Query()
+		if name := q.Get("name"); name != "my volume" {
+			t.Errorf("Request name = %q, want %q", name, "my volume")
+		}
+		if q.Get("page") != "" {
+			t.Errorf("Request page = %q, want empty", q.Get("page"))
+		}
+
+		fmt.Fprint(w, `{"volumes":[{"id":"v1"}]}`)
+	})
+
+	params := &ListVolumeParams{Name: "my volume"}
+
+	_, _, err := client.Storage.ListVolumes(ctx, params)
+	if err != nil {
+		t.Fatalf("Storage.ListVolumes returned error: %v", err)
+	}
+}
+
+func TestStorage_GetVolume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes/vol-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"volume":{"id":"vol-1","name":"my-volume"}}`)
+	})
+
+	volume, _, err := client.Storage.GetVolume(ctx, "vol-1")
+	if err != nil {
+		t.Fatalf("Storage.GetVolume returned error: %v", err)
+	}
+
+	if volume.ID != "vol-1" || volume.Name != "my-volume" {
+		t.Errorf("Storage.GetVolume returned %+v, want id vol-1 / name my-volume", volume)
+	}
+}
+
+func TestStorage_CreateVolume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := &VolumeCreateRequest{
+		Region:        "nyc1",
+		Name:          "my-volume",
+		Description:   "test volume",
+		SizeGigaBytes: 100,
+	}
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		got := new(VolumeCreateRequest)
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if *got != *want {
+			t.Errorf("Storage.CreateVolume request body = %+v, want %+v", got, want)
+		}
+
+		fmt.Fprint(w, `{"volume":{"id":"vol-1","name":"my-volume"}}`)
+	})
+
+	volume, _, err := client.Storage.CreateVolume(ctx, want)
+	if err != nil {
+		t.Fatalf("Storage.CreateVolume returned error: %v", err)
+	}
+
+	if volume.ID != "vol-1" || volume.Name != "my-volume" {
+		t.Errorf("Storage.CreateVolume returned %+v, want id vol-1 / name my-volume", volume)
+	}
+}
+
+func TestStorage_DeleteVolume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes/vol-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Storage.DeleteVolume(ctx, "vol-1")
+	if err != nil {
+		t.Fatalf("Storage.DeleteVolume returned error: %v", err)
+	}
+}
+
+func TestStorage_ListSnapshots(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes/vol-1/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"snapshots":[{"id":1},{"id":2}]}`)
+	})
+
+	snapshots, _, err := client.Storage.ListSnapshots(ctx, "vol-1", nil)
+	if err != nil {
+		t.Fatalf("Storage.ListSnapshots returned error: %v", err)
+	}
+
+	if len(snapshots) != 2 {
+		t.Errorf("Storage.ListSnapshots returned %d snapshots, want 2", len(snapshots))
+	}
+}
@@ -0,0 +1,175 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+)
+
+const tagsBasePath = "v2/tags"
+
+// TagsService is an interface for interfacing with the tags
+// endpoints of the DigitalOcean API.
+// See: https://developers.digitalocean.com/documentation/v2#tags
+type TagsService interface {
+	List(context.Context, *ListOptions) ([]Tag, *Response, error)
+	Get(context.Context, string) (*Tag, *Response, error)
+	Create(context.Context, string) (*Tag, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+	TagResources(context.Context, string, *TagResourcesRequest) (*Response, error)
+	UntagResources(context.Context, string, *UntagResourcesRequest) (*Response, error)
+}
+
+// TagsServiceOp handles communication with tag related methods of the
+// DigitalOcean API.
+type TagsServiceOp struct {
+	client *Client
+}
+
+var _ TagsService = &TagsServiceOp{}
+
+// Tag represents a DigitalOcean Tag.
+type Tag struct {
+	Name      string           `json:"name,omitempty"`
+	Resources *TaggedResources `json:"resources,omitempty"`
+}
+
+// String creates a human-readable description of a Tag.
+func (t Tag) String() string {
+	return Stringify(t)
+}
+
+// TaggedResources is a summary of the resources tagged by a single tag.
+type TaggedResources struct {
+	Droplets *TaggedDropletsResources `json:"droplets,omitempty"`
+}
+
+// TaggedDropletsResources summarizes the droplets tagged by a single tag.
+type TaggedDropletsResources struct {
+	Count      int      `json:"count,omitempty"`
+	LastTagged *Droplet `json:"last_tagged,omitempty"`
+}
+
+// Resource represents a single resource that can be tagged or untagged.
+type Resource struct {
+	ID   string `json:"resource_id,omitempty"`
+	Type string `json:"resource_type,omitempty"`
+}
+
+// TagCreateRequest represents the JSON body to create a tag.
+type TagCreateRequest struct {
+	Name string `json:"name"`
+}
+
+// TagResourcesRequest represents the JSON body to tag a set of resources.
+type TagResourcesRequest struct {
+	Resources []Resource `json:"resources"`
+}
+
+// UntagResourcesRequest represents the JSON body to untag a set of
+// resources.
+type UntagResourcesRequest struct {
+	Resources []Resource `json:"resources"`
+}
+
+type tagsRoot struct {
+	Tags  []Tag  `json:"tags"`
+	Links *Links `json:"links"`
+}
+
+type tagRoot struct {
+	Tag *Tag `json:"tag"`
+}
+
+// List all tags.
+func (s *TagsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Tag, *Response, error) {
+	path, err := addOptions(tagsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(tagsRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Tags, resp, err
+}
+
+// Get an individual tag by its name.
+func (s *TagsServiceOp) Get(ctx context.Context, name string) (*Tag, *Response, error) {
+	path := fmt.Sprintf("%s/%s", tagsBasePath, name)
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(tagRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Tag, resp, err
+}
+
+// Create a new tag.
+func (s *TagsServiceOp) Create(ctx context.Context, name string) (*Tag, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", tagsBasePath, &TagCreateRequest{Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(tagRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Tag, resp, err
+}
+
+// Delete an existing tag.
+func (s *TagsServiceOp) Delete(ctx context.Context, name string) (*Response, error) {
+	path := fmt.Sprintf("%s/%s", tagsBasePath, name)
+
+	req, err := s.client.NewRequest(ctx, "DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// TagResources tags a set of resources with an existing tag.
+func (s *TagsServiceOp) TagResources(ctx context.Context, name string, tagRequest *TagResourcesRequest) (*Response, error) {
+	path := fmt.Sprintf("%s/%s/resources", tagsBasePath, name)
+
+	req, err := s.client.NewRequest(ctx, "POST", path, tagRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// UntagResources removes a tag from a set of resources.
+func (s *TagsServiceOp) UntagResources(ctx context.Context, name string, untagRequest *UntagResourcesRequest) (*Response, error) {
+	path := fmt.Sprintf("%s/%s/resources", tagsBasePath, name)
+
+	req, err := s.client.NewRequest(ctx, "DELETE", path, untagRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,108 @@
+package godo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestTags_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"tags":[{"name":"prod"},{"name":"staging"}]}`)
+	})
+
+	tags, _, err := client.Tags.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("Tags.List returned error: %v", err)
+	}
+
+	if len(tags) != 2 {
+		t.Errorf("Tags.List returned %d tags, want 2", len(tags))
+	}
+}
+
+func TestTags_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags/prod", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"tag":{"name":"prod"}}`)
+	})
+
+	tag, _, err := client.Tags.Get(ctx, "prod")
+	if err != nil {
+		t.Fatalf("Tags.Get returned error: %v", err)
+	}
+
+	if tag.Name != "prod" {
+		t.Errorf("Tags.Get returned %+v, want name prod", tag)
+	}
+}
+
+func TestTags_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"tag":{"name":"prod"}}`)
+	})
+
+	tag, _, err := client.Tags.Create(ctx, "prod")
+	if err != nil {
+		t.Fatalf("Tags.Create returned error: %v", err)
+	}
+
+	if tag.Name != "prod" {
+		t.Errorf("Tags.Create returned %+v, want name prod", tag)
+	}
+}
+
+func TestTags_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags/prod", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Tags.Delete(ctx, "prod")
+	if err != nil {
+		t.Fatalf("Tags.Delete returned error: %v", err)
+	}
+}
+
+func TestTags_TagResources(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags/prod/resources", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+	})
+
+	req := &TagResourcesRequest{Resources: []Resource{{ID: "1", Type: "droplet"}}}
+	_, err := client.Tags.TagResources(ctx, "prod", req)
+	if err != nil {
+		t.Fatalf("Tags.TagResources returned error: %v", err)
+	}
+}
+
+func TestTags_UntagResources(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags/prod/resources", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	req := &UntagResourcesRequest{Resources: []Resource{{ID: "1", Type: "droplet"}}}
+	_, err := client.Tags.UntagResources(ctx, "prod", req)
+	if err != nil {
+		t.Fatalf("Tags.UntagResources returned error: %v", err)
+	}
+}